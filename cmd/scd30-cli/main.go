@@ -0,0 +1,57 @@
+// Command scd30-cli wires the console package to a Sensirion SCD30 over
+// stdin/stdout, for calibrating and inspecting a deployed sensor from a PC
+// without recompiling firmware. It talks to the sensor over a Linux I2C
+// bus (e.g. a Raspberry Pi or a USB-I2C adapter exposing /dev/i2c-*).
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"periph.io/x/conn/v3/i2c"
+	"periph.io/x/conn/v3/i2c/i2creg"
+	"periph.io/x/host/v3"
+
+	"github.com/antonfisher/scd30"
+	"github.com/antonfisher/scd30/console"
+)
+
+func main() {
+	busName := flag.String("i2c-bus", "", "I2C bus name or number, empty for the default bus")
+	flag.Parse()
+
+	if _, err := host.Init(); err != nil {
+		log.Fatalf("failed to initialize host: %v", err)
+	}
+
+	bus, err := i2creg.Open(*busName)
+	if err != nil {
+		log.Fatalf("failed to open I2C bus: %v", err)
+	}
+	defer bus.Close()
+
+	d := scd30.New(i2cBus{bus})
+
+	if err := console.Serve(&d, stdioReadWriter{}); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// i2cBus adapts a periph.io i2c.Bus to the scd30.I2C interface, which
+// (matching TinyGo's machine.I2C) takes the device address per-call rather
+// than binding it to the bus.
+type i2cBus struct {
+	bus i2c.Bus
+}
+
+func (b i2cBus) Tx(addr uint16, w, r []byte) error {
+	dev := &i2c.Dev{Addr: addr, Bus: b.bus}
+	return dev.Tx(w, r)
+}
+
+// stdioReadWriter adapts os.Stdin/os.Stdout to io.ReadWriter for console.Serve.
+type stdioReadWriter struct{}
+
+func (stdioReadWriter) Read(p []byte) (int, error)  { return os.Stdin.Read(p) }
+func (stdioReadWriter) Write(p []byte) (int, error) { return os.Stdout.Write(p) }
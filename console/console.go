@@ -0,0 +1,208 @@
+// Package console implements a small line-based command interface for
+// calibrating and inspecting a Sensirion SCD30 deployed in the field, over
+// any io.ReadWriter (UART, SSH, a pipe in tests, ...).
+package console
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/antonfisher/scd30"
+)
+
+const prompt = "scd30> "
+
+const help = `commands:
+  help              show this help
+  read              read a single measurement
+  interval <s>      get/set measurement interval, seconds [2-1800]
+  asc on|off        get/set automatic self-calibration
+  frc <ppm>         set forced recalibration value [400-2000]
+  offset <1/100C>   set temperature offset
+  altitude <m>      set altitude compensation, meters
+  pressure <mBar>   (re)start continuous measurement with ambient pressure
+  start             start continuous measurement
+  stop              stop continuous measurement
+  reset             soft-reset the sensor
+  version           read firmware version
+  stream <n>        read and print n measurements, one per measurement interval
+`
+
+// Serve reads whitespace-separated commands from rw, one per line, runs
+// them against d, and writes their results back to rw. Serve blocks until
+// rw is closed or a read error occurs.
+func Serve(d *scd30.Device, rw io.ReadWriter) error {
+	scanner := bufio.NewScanner(rw)
+
+	fmt.Fprint(rw, prompt)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" {
+			fields := strings.Fields(line)
+			if err := dispatch(d, rw, fields[0], fields[1:]); err != nil {
+				fmt.Fprintf(rw, "error: %v\n", err)
+			}
+		}
+		fmt.Fprint(rw, prompt)
+	}
+
+	return scanner.Err()
+}
+
+func dispatch(d *scd30.Device, w io.Writer, cmd string, args []string) error {
+	switch cmd {
+	case "help":
+		fmt.Fprint(w, help)
+
+	case "read":
+		m, err := d.ReadMeasurement()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, m.String())
+
+	case "interval":
+		if len(args) == 0 {
+			interval, err := d.GetMeasurementInterval()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%ds\n", interval)
+			return nil
+		}
+		interval, err := strconv.ParseUint(args[0], 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid interval: %w", err)
+		}
+		return d.SetMeasurementInterval(uint16(interval))
+
+	case "asc":
+		if len(args) == 0 {
+			enabled, err := d.GetSelfCalibration()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%t\n", enabled)
+			return nil
+		}
+		switch args[0] {
+		case "on":
+			return d.SetSelfCalibration(true)
+		case "off":
+			return d.SetSelfCalibration(false)
+		default:
+			return fmt.Errorf("usage: asc on|off")
+		}
+
+	case "frc":
+		if len(args) == 0 {
+			value, err := d.GetForcedRecalibrationValue()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%dppm\n", value)
+			return nil
+		}
+		ppm, err := strconv.ParseUint(args[0], 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid ppm: %w", err)
+		}
+		return d.SetForcedRecalibrationValue(uint16(ppm))
+
+	case "offset":
+		if len(args) == 0 {
+			offset, err := d.GetTemperatureOffset()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%d/100C\n", offset)
+			return nil
+		}
+		offset, err := strconv.ParseUint(args[0], 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid offset: %w", err)
+		}
+		return d.SetTemperatureOffset(uint16(offset))
+
+	case "altitude":
+		if len(args) == 0 {
+			altitude, err := d.GetAltitudeCompensation()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%dm\n", altitude)
+			return nil
+		}
+		altitude, err := strconv.ParseUint(args[0], 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid altitude: %w", err)
+		}
+		return d.SetAltitudeCompensation(uint16(altitude))
+
+	case "pressure":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: pressure <mBar>")
+		}
+		pressure, err := strconv.ParseUint(args[0], 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid pressure: %w", err)
+		}
+		return d.StartContinuousMeasurement(uint16(pressure))
+
+	case "start":
+		return d.StartContinuousMeasurement(0)
+
+	case "stop":
+		return d.StopContinuousMeasurement()
+
+	case "reset":
+		return d.SoftReset()
+
+	case "version":
+		version, err := d.GetSoftwareVersion()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, version)
+
+	case "stream":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: stream <n>")
+		}
+		n, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid count: %w", err)
+		}
+		return stream(d, w, n)
+
+	default:
+		return fmt.Errorf("unknown command: %q, try \"help\"", cmd)
+	}
+
+	return nil
+}
+
+func stream(d *scd30.Device, w io.Writer, n int) error {
+	interval, err := d.GetMeasurementInterval()
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < n; i++ {
+		m, err := d.ReadMeasurement()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, m.String())
+
+		if i < n-1 {
+			time.Sleep(time.Duration(interval) * time.Second)
+		}
+	}
+
+	return nil
+}
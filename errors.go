@@ -0,0 +1,29 @@
+package scd30
+
+import "errors"
+
+// Typed errors returned (possibly wrapped) by Device methods, so callers
+// can react programmatically via errors.Is instead of matching error
+// strings. All four are treated as transient: a transaction failing with
+// one of them is attempted up to Device.MaxRetries times. ErrTimeout is
+// returned when the I2C backend reports context.DeadlineExceeded;
+// ErrNotReady is not returned by any Device method in this package.
+var (
+	// ErrCRC indicates a CRC8 checksum mismatch in a sensor response.
+	ErrCRC = errors.New("scd30: CRC checksum mismatch")
+
+	// ErrBusIO indicates a low-level I2C bus failure (e.g. a NACK).
+	ErrBusIO = errors.New("scd30: I2C bus I/O error")
+
+	// ErrTimeout indicates the I2C transaction did not complete in time.
+	ErrTimeout = errors.New("scd30: I2C transaction timed out")
+
+	// ErrNotReady indicates the sensor is not ready to service the request.
+	ErrNotReady = errors.New("scd30: sensor not ready")
+)
+
+// isTransient reports whether err is worth retrying.
+func isTransient(err error) bool {
+	return errors.Is(err, ErrCRC) || errors.Is(err, ErrBusIO) ||
+		errors.Is(err, ErrTimeout) || errors.Is(err, ErrNotReady)
+}
@@ -0,0 +1,219 @@
+package scd30
+
+import (
+	"fmt"
+	"math"
+)
+
+// IAQLevel is a coarse CO2-based indoor-air-quality rating, derived from
+// user-configurable ppm thresholds.
+type IAQLevel uint8
+
+const (
+	IAQGood IAQLevel = iota
+	IAQModerate
+	IAQPoor
+	IAQBad
+)
+
+func (l IAQLevel) String() string {
+	switch l {
+	case IAQGood:
+		return "good"
+	case IAQModerate:
+		return "moderate"
+	case IAQPoor:
+		return "poor"
+	case IAQBad:
+		return "bad"
+	default:
+		return "unknown"
+	}
+}
+
+// Processed is a Measurement augmented with the values derived by Filter.
+type Processed struct {
+	Measurement
+
+	DewPoint         float32 // °C
+	AbsoluteHumidity float32 // g/m³
+	IAQ              IAQLevel
+}
+
+func (p *Processed) String() string {
+	return fmt.Sprintf(
+		"%s, dew point: %f °C, absolute humidity: %f g/m³, IAQ: %s",
+		p.Measurement.String(),
+		p.DewPoint,
+		p.AbsoluteHumidity,
+		p.IAQ,
+	)
+}
+
+// IAQThresholds are the CO2 ppm boundaries (moderate, poor, bad) used by
+// Filter to derive Processed.IAQ.
+type IAQThresholds struct {
+	Moderate float32 // default 800ppm
+	Poor     float32 // default 1200ppm
+	Bad      float32 // default 2000ppm
+}
+
+// DefaultIAQThresholds are the thresholds used by NewFilter.
+var DefaultIAQThresholds = IAQThresholds{
+	Moderate: 800,
+	Poor:     1200,
+	Bad:      2000,
+}
+
+// Filter is a composable post-processing pipeline applied to raw
+// Measurements: a rolling-median spike rejection, followed by EWMA
+// smoothing, followed by the derived dew point/absolute humidity/IAQ
+// values. A Filter is not safe for concurrent use.
+type Filter struct {
+	// Alpha is the EWMA smoothing factor in (0,1]; smaller is smoother.
+	Alpha float32
+
+	// IAQThresholds are the CO2 ppm boundaries used to compute Processed.IAQ.
+	IAQThresholds IAQThresholds
+
+	windowCO2  ring
+	windowTemp ring
+	windowHum  ring
+
+	emaCO2  float32
+	emaTemp float32
+	emaHum  float32
+
+	initialized bool
+}
+
+// NewFilter creates a Filter with a rolling-median window of the given
+// size (clamped to [1,15]) and the provided EWMA alpha.
+func NewFilter(medianWindow int, alpha float32) Filter {
+	if medianWindow < 1 {
+		medianWindow = 1
+	}
+	if medianWindow > 15 {
+		medianWindow = 15
+	}
+
+	return Filter{
+		Alpha:         alpha,
+		IAQThresholds: DefaultIAQThresholds,
+		windowCO2:     newRing(medianWindow),
+		windowTemp:    newRing(medianWindow),
+		windowHum:     newRing(medianWindow),
+	}
+}
+
+// Apply runs m through the median and EWMA stages and derives dew point,
+// absolute humidity, and IAQ from the smoothed result.
+func (f *Filter) Apply(m Measurement) Processed {
+	co2 := f.windowCO2.pushMedian(m.CO2)
+	temp := f.windowTemp.pushMedian(m.Temperature)
+	hum := f.windowHum.pushMedian(m.Humidity)
+
+	if !f.initialized {
+		f.emaCO2, f.emaTemp, f.emaHum = co2, temp, hum
+		f.initialized = true
+	} else {
+		f.emaCO2 = ewma(f.Alpha, co2, f.emaCO2)
+		f.emaTemp = ewma(f.Alpha, temp, f.emaTemp)
+		f.emaHum = ewma(f.Alpha, hum, f.emaHum)
+	}
+
+	p := Processed{
+		Measurement: Measurement{
+			CO2:         f.emaCO2,
+			Temperature: f.emaTemp,
+			Humidity:    f.emaHum,
+		},
+	}
+	p.DewPoint = dewPoint(p.Temperature, p.Humidity)
+	p.AbsoluteHumidity = absoluteHumidity(p.Temperature, p.Humidity)
+	p.IAQ = f.iaqLevel(p.CO2)
+
+	return p
+}
+
+func (f *Filter) iaqLevel(co2 float32) IAQLevel {
+	switch {
+	case co2 >= f.IAQThresholds.Bad:
+		return IAQBad
+	case co2 >= f.IAQThresholds.Poor:
+		return IAQPoor
+	case co2 >= f.IAQThresholds.Moderate:
+		return IAQModerate
+	default:
+		return IAQGood
+	}
+}
+
+// ewma computes y = alpha*x + (1-alpha)*yPrev.
+func ewma(alpha, x, yPrev float32) float32 {
+	return alpha*x + (1-alpha)*yPrev
+}
+
+// dewPoint approximates the dew point in °C using the Magnus formula.
+func dewPoint(tempC, relHumidity float32) float32 {
+	t, rh := float64(tempC), float64(relHumidity)
+	gamma := math.Log(rh/100) + 17.62*t/(243.12+t)
+
+	return float32(243.12 * gamma / (17.62 - gamma))
+}
+
+// absoluteHumidity approximates absolute humidity in g/m³.
+func absoluteHumidity(tempC, relHumidity float32) float32 {
+	t, rh := float64(tempC), float64(relHumidity)
+	ah := 216.7 * (rh / 100 * 6.112 * math.Exp(17.62*t/(243.12+t)) / (273.15 + t))
+
+	return float32(ah)
+}
+
+// ring is a fixed-size ring buffer of float32 samples used for rolling
+// median spike rejection. It allocates once, at construction.
+type ring struct {
+	buf     []float32
+	scratch []float32
+	next    int
+	filled  int
+}
+
+func newRing(size int) ring {
+	return ring{
+		buf:     make([]float32, size),
+		scratch: make([]float32, size),
+	}
+}
+
+// pushMedian stores x and returns the median of the samples currently in
+// the window.
+func (r *ring) pushMedian(x float32) float32 {
+	r.buf[r.next] = x
+	r.next = (r.next + 1) % len(r.buf)
+	if r.filled < len(r.buf) {
+		r.filled++
+	}
+
+	n := copy(r.scratch, r.buf[:r.filled])
+	scratch := r.scratch[:n]
+	insertionSort(scratch)
+
+	return scratch[n/2]
+}
+
+// insertionSort sorts small slices (window size is capped at 15 by
+// NewFilter) in place without allocating, unlike sort.Slice, which boxes a
+// closure and a reflection-based swapper on every call.
+func insertionSort(a []float32) {
+	for i := 1; i < len(a); i++ {
+		v := a[i]
+
+		j := i - 1
+		for j >= 0 && a[j] > v {
+			a[j+1] = a[j]
+			j--
+		}
+		a[j+1] = v
+	}
+}
@@ -0,0 +1,86 @@
+package scd30
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEWMA(t *testing.T) {
+	if got, want := ewma(0.5, 10, 20), float32(15); got != want {
+		t.Errorf("ewma(0.5, 10, 20) = %v, want %v", got, want)
+	}
+}
+
+func TestDewPoint(t *testing.T) {
+	// Reference value from the Magnus formula at 25°C/50%RH: ~13.86°C.
+	got := dewPoint(25, 50)
+	if want := float32(13.86); math.Abs(float64(got-want)) > 0.1 {
+		t.Errorf("dewPoint(25, 50) = %v, want ~%v", got, want)
+	}
+}
+
+func TestAbsoluteHumidity(t *testing.T) {
+	// Reference value at 25°C/50%RH: ~11.5 g/m³.
+	got := absoluteHumidity(25, 50)
+	if want := float32(11.5); math.Abs(float64(got-want)) > 0.5 {
+		t.Errorf("absoluteHumidity(25, 50) = %v, want ~%v", got, want)
+	}
+}
+
+func TestInsertionSort(t *testing.T) {
+	a := []float32{5, 3, 4, 1, 2}
+	insertionSort(a)
+
+	want := []float32{1, 2, 3, 4, 5}
+	for i := range want {
+		if a[i] != want[i] {
+			t.Fatalf("insertionSort result = %v, want %v", a, want)
+		}
+	}
+}
+
+func TestRingPushMedianRejectsSpike(t *testing.T) {
+	r := newRing(3)
+	r.pushMedian(10)
+	r.pushMedian(10)
+
+	if got, want := r.pushMedian(1000), float32(10); got != want {
+		t.Errorf("pushMedian spike = %v, want %v (spike rejected)", got, want)
+	}
+}
+
+func TestFilterIAQLevel(t *testing.T) {
+	cases := []struct {
+		co2  float32
+		want IAQLevel
+	}{
+		{500, IAQGood},
+		{900, IAQModerate},
+		{1300, IAQPoor},
+		{2100, IAQBad},
+	}
+
+	for _, c := range cases {
+		// alpha=1 disables smoothing and window=1 disables spike rejection,
+		// so CO2 passes straight through for this table test.
+		f := NewFilter(1, 1)
+
+		p := f.Apply(Measurement{CO2: c.co2})
+		if p.IAQ != c.want {
+			t.Errorf("IAQ(%vppm) = %v, want %v", c.co2, p.IAQ, c.want)
+		}
+	}
+}
+
+func TestFilterApplyAllocationFree(t *testing.T) {
+	f := NewFilter(5, 0.3)
+	m := Measurement{CO2: 500, Temperature: 20, Humidity: 40}
+	f.Apply(m) // seed the EWMA state
+
+	allocs := testing.AllocsPerRun(100, func() {
+		f.Apply(m)
+	})
+	if allocs != 0 {
+		t.Errorf("Filter.Apply allocated %v times per call, want 0", allocs)
+	}
+}
@@ -0,0 +1,38 @@
+package logsink
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/antonfisher/scd30"
+)
+
+// CSVSink writes measurements as CSV rows, prefixed with a header on the
+// first write.
+type CSVSink struct {
+	w      io.Writer
+	header bool
+}
+
+// NewCSVSink creates a CSVSink writing to w.
+func NewCSVSink(w io.Writer) *CSVSink {
+	return &CSVSink{w: w}
+}
+
+// Write appends a single CSV row for m, writing the header first if this
+// is the first call.
+func (s *CSVSink) Write(t time.Time, m scd30.Measurement) error {
+	if !s.header {
+		if _, err := fmt.Fprintln(s.w, "timestamp,co2_ppm,temperature_c,humidity_rh"); err != nil {
+			return err
+		}
+		s.header = true
+	}
+
+	_, err := fmt.Fprintf(
+		s.w, "%s,%f,%f,%f\n", t.Format(time.RFC3339), m.CO2, m.Temperature, m.Humidity,
+	)
+
+	return err
+}
@@ -0,0 +1,44 @@
+package logsink
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/antonfisher/scd30"
+)
+
+// JSONLSink writes measurements as newline-delimited JSON objects.
+type JSONLSink struct {
+	w io.Writer
+}
+
+// NewJSONLSink creates a JSONLSink writing to w.
+func NewJSONLSink(w io.Writer) *JSONLSink {
+	return &JSONLSink{w: w}
+}
+
+type jsonlRecord struct {
+	Time        time.Time `json:"time"`
+	CO2         float32   `json:"co2_ppm"`
+	Temperature float32   `json:"temperature_c"`
+	Humidity    float32   `json:"humidity_rh"`
+}
+
+// Write appends a single JSON object for m, followed by a newline.
+func (s *JSONLSink) Write(t time.Time, m scd30.Measurement) error {
+	data, err := json.Marshal(jsonlRecord{
+		Time:        t,
+		CO2:         m.CO2,
+		Temperature: m.Temperature,
+		Humidity:    m.Humidity,
+	})
+	if err != nil {
+		return err
+	}
+
+	data = append(data, '\n')
+	_, err = s.w.Write(data)
+
+	return err
+}
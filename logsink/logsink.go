@@ -0,0 +1,15 @@
+// Package logsink provides measurement logging sinks (CSV, JSON Lines) with
+// an optional size/time based file rotator, for long-running deployments
+// that need to capture measurement history to disk.
+package logsink
+
+import (
+	"time"
+
+	"github.com/antonfisher/scd30"
+)
+
+// Sink persists a single measurement, timestamped at the time it was taken.
+type Sink interface {
+	Write(t time.Time, m scd30.Measurement) error
+}
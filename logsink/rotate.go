@@ -0,0 +1,150 @@
+package logsink
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/antonfisher/scd30"
+)
+
+// RotatingFileSink wraps a Sink constructor and rolls to a new file once
+// the current one exceeds MaxSize bytes or RotateEvery has elapsed,
+// whichever comes first. A zero MaxSize or RotateEvery disables that
+// trigger. RotatingFileSink is safe for concurrent use.
+type RotatingFileSink struct {
+	// Dir is the directory files are created in.
+	Dir string
+
+	// Prefix is prepended to each file name, followed by a timestamp and
+	// Ext, e.g. Prefix "scd30" and Ext "csv" produces
+	// "scd30-20231002-150405.csv" (or "...-150405-1.csv" if a rotation
+	// within the same second already claimed the unsuffixed name).
+	Prefix string
+	Ext    string
+
+	// NewSink wraps the currently open file in a Sink, e.g. NewCSVSink.
+	NewSink func(io.Writer) Sink
+
+	// MaxSize rotates the file once its size exceeds this many bytes.
+	// Zero disables size-based rotation.
+	MaxSize int64
+
+	// RotateEvery rotates the file once it has been open for this long,
+	// e.g. 24*time.Hour for daily rotation. Zero disables time-based
+	// rotation.
+	RotateEvery time.Duration
+
+	mu     sync.Mutex
+	file   *countingFile
+	sink   Sink
+	opened time.Time
+}
+
+// NewRotatingFileSink creates a RotatingFileSink. prefix and ext name the
+// files created in dir; newSink wraps each new file in a Sink, e.g.
+// NewCSVSink or NewJSONLSink.
+func NewRotatingFileSink(
+	dir, prefix, ext string, newSink func(io.Writer) Sink, maxSize int64, rotateEvery time.Duration,
+) *RotatingFileSink {
+	return &RotatingFileSink{
+		Dir:         dir,
+		Prefix:      prefix,
+		Ext:         ext,
+		NewSink:     newSink,
+		MaxSize:     maxSize,
+		RotateEvery: rotateEvery,
+	}
+}
+
+// Write appends a single measurement, rotating to a new file first if
+// needed.
+func (s *RotatingFileSink) Write(t time.Time, m scd30.Measurement) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.sink == nil || s.shouldRotate(t) {
+		if err := s.rotate(t); err != nil {
+			return err
+		}
+	}
+
+	return s.sink.Write(t, m)
+}
+
+// Close closes the currently open file, if any.
+func (s *RotatingFileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+
+	return s.file.Close()
+}
+
+func (s *RotatingFileSink) shouldRotate(t time.Time) bool {
+	if s.MaxSize > 0 && s.file.size > s.MaxSize {
+		return true
+	}
+	if s.RotateEvery > 0 && t.Sub(s.opened) >= s.RotateEvery {
+		return true
+	}
+
+	return false
+}
+
+// maxRotateAttempts bounds the name-collision retry loop in rotate: past
+// this many rotations within the same second, something is very wrong.
+const maxRotateAttempts = 1000
+
+func (s *RotatingFileSink) rotate(t time.Time) error {
+	if s.file != nil {
+		if err := s.file.Close(); err != nil {
+			return err
+		}
+	}
+
+	base := t.Format("20060102-150405")
+
+	var f *os.File
+	for attempt := 0; ; attempt++ {
+		name := fmt.Sprintf("%s-%s.%s", s.Prefix, base, s.Ext)
+		if attempt > 0 {
+			name = fmt.Sprintf("%s-%s-%d.%s", s.Prefix, base, attempt, s.Ext)
+		}
+
+		var err error
+		f, err = os.OpenFile(filepath.Join(s.Dir, name), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err == nil {
+			break
+		}
+		if !os.IsExist(err) || attempt >= maxRotateAttempts {
+			return fmt.Errorf("failed to create log file: %w", err)
+		}
+	}
+
+	s.file = &countingFile{File: f}
+	s.sink = s.NewSink(s.file)
+	s.opened = t
+
+	return nil
+}
+
+// countingFile tracks bytes written through it, so RotatingFileSink can
+// apply MaxSize without every Sink implementation reporting its own size.
+type countingFile struct {
+	*os.File
+	size int64
+}
+
+func (f *countingFile) Write(p []byte) (int, error) {
+	n, err := f.File.Write(p)
+	f.size += int64(n)
+
+	return n, err
+}
@@ -0,0 +1,100 @@
+package scd30
+
+import (
+	"fmt"
+	"time"
+)
+
+// SetPressureSource configures a callback used by
+// StartPressureAutoCompensation to obtain the current ambient pressure from
+// a companion barometer (e.g. a BME280/BMP280).
+func (d *Device) SetPressureSource(source func() (uint16, error)) {
+	d.pressureSource = source
+}
+
+// StartPressureAutoCompensation starts a background goroutine that polls
+// the configured pressure source every interval and re-issues
+// StartContinuousMeasurement with the new reading whenever it changes by
+// more than threshold mBar. The pressure value is clamped to [700,1200]
+// before being sent, as required by StartContinuousMeasurement.
+//
+// SetPressureSource must be called first. Call StopPressureAutoCompensation
+// to stop the updater. It is safe to run alongside a concurrent poll loop
+// (e.g. sensor.Sensor.Run) on the same Device: bus access is serialized by
+// Device internally.
+func (d *Device) StartPressureAutoCompensation(
+	interval time.Duration, threshold uint16,
+) error {
+	if interval <= 0 {
+		return fmt.Errorf("invalid interval: %s, must be positive", interval)
+	}
+	if d.pressureSource == nil {
+		return fmt.Errorf("pressure source is not set, call SetPressureSource first")
+	}
+	if d.pressureStop != nil {
+		return fmt.Errorf("pressure auto-compensation is already running")
+	}
+
+	d.pressureStop = make(chan struct{})
+	stop := d.pressureStop
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		var last uint16
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				pressure, err := d.pressureSource()
+				if err != nil {
+					continue
+				}
+
+				pressure = clampAmbientPressure(pressure)
+				if last != 0 && absDiffUint16(pressure, last) <= threshold {
+					continue
+				}
+
+				if err := d.StartContinuousMeasurement(pressure); err != nil {
+					continue
+				}
+				last = pressure
+			}
+		}
+	}()
+
+	return nil
+}
+
+// StopPressureAutoCompensation stops the background updater started by
+// StartPressureAutoCompensation, if running.
+func (d *Device) StopPressureAutoCompensation() {
+	if d.pressureStop == nil {
+		return
+	}
+
+	close(d.pressureStop)
+	d.pressureStop = nil
+}
+
+func clampAmbientPressure(p uint16) uint16 {
+	switch {
+	case p < 700:
+		return 700
+	case p > 1200:
+		return 1200
+	default:
+		return p
+	}
+}
+
+func absDiffUint16(a, b uint16) uint16 {
+	if a > b {
+		return a - b
+	}
+
+	return b - a
+}
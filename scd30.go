@@ -15,8 +15,11 @@
 package scd30
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math"
+	"sync"
 	"time"
 )
 
@@ -35,32 +38,120 @@ type Device struct {
 	// stretching. Sensirion recommends to operate the SCD30 at a baud rate
 	// of 50 kHz or smaller.
 	bus I2C
+
+	// MaxRetries is how many times a transaction is attempted before a
+	// transient error (ErrCRC, ErrBusIO, ErrTimeout, ErrNotReady) is
+	// returned to the caller. Values below 1 are treated as 1.
+	MaxRetries int
+
+	// busMu serializes transact calls across goroutines, since each I2C
+	// transaction is two Tx calls (write, then read after clock stretching)
+	// that must not interleave with another goroutine's transaction on the
+	// same Device, e.g. a Sensor poll loop racing a pressure auto-
+	// compensation updater. It's a pointer so Device stays copyable, as New
+	// returns it by value.
+	busMu *sync.Mutex
+
+	pressureSource func() (uint16, error)
+	pressureStop   chan struct{}
 }
 
-// readResponse writes IC2 command and reads result to the provided response
-// byte array.
-func (d *Device) readResponse(command uint16, response []byte) (
+// transact writes command, optionally waits out clock stretching, reads
+// len(response) bytes back into response, and runs validate (if non-nil)
+// against the result. On transient errors the transaction is attempted up
+// to Device.MaxRetries times in all, with a short backoff between attempts. transact holds Device's bus
+// lock for the whole operation, so it is safe to call concurrently from
+// multiple goroutines sharing the same Device.
+func (d *Device) transact(command []byte, response []byte, validate func([]byte) error) (
 	err error,
 ) {
-	err = d.bus.Tx(
-		uint16(d.Address),
-		[]byte{uint8(command >> 8), uint8(command & 0xFF)},
-		[]byte{},
-	)
+	d.busMu.Lock()
+	defer d.busMu.Unlock()
+
+	retries := d.MaxRetries
+	if retries < 1 {
+		retries = 1
+	}
+
+	for attempt := 0; attempt < retries; attempt++ {
+		err = d.transactOnce(command, response, validate)
+		if err == nil || !isTransient(err) {
+			return err
+		}
+		if attempt < retries-1 {
+			time.Sleep(retryBackoff(attempt))
+		}
+	}
+
+	return err
+}
+
+func (d *Device) transactOnce(command []byte, response []byte, validate func([]byte) error) (
+	err error,
+) {
+	err = d.bus.Tx(uint16(d.Address), command, []byte{})
 	if err != nil {
-		return fmt.Errorf("failed to send data: %w", err)
+		return wrapBusError("failed to send data", err)
+	}
+
+	if len(response) == 0 {
+		return nil
 	}
 
 	time.Sleep(d.ClockStretching)
 
 	err = d.bus.Tx(uint16(d.Address), []byte{}, response)
 	if err != nil {
-		return fmt.Errorf("failed to read data: %w", err)
+		return wrapBusError("failed to read data", err)
+	}
+
+	if validate != nil {
+		return validate(response)
+	}
+
+	return nil
+}
+
+// wrapBusError classifies a raw I2C error as ErrTimeout or ErrBusIO.
+func wrapBusError(msg string, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%s: %w: %v", msg, ErrTimeout, err)
+	}
+
+	return fmt.Errorf("%s: %w: %v", msg, ErrBusIO, err)
+}
+
+// retryBackoff returns the delay after failed attempt (0-indexed) attempt,
+// before attempt+1 is made.
+func retryBackoff(attempt int) time.Duration {
+	const (
+		base       = 10 * time.Millisecond
+		maxBackoff = 500 * time.Millisecond
+	)
+
+	backoff := base << attempt
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	return backoff
+}
+
+// validateCRC8 checks CRC8 of a response assuming the last byte is the
+// checksum, wrapping a mismatch as ErrCRC.
+func validateCRC8(response []byte) error {
+	if err := checkCRC8(response); err != nil {
+		return fmt.Errorf("%w: %v", ErrCRC, err)
 	}
 
 	return nil
 }
 
+// commandBytes splits a command into its big-endian wire representation.
+func commandBytes(command uint16) []byte {
+	return []byte{uint8(command >> 8), uint8(command & 0xFF)}
+}
+
 // readAndCheckResponse reads the register and checks CRC8 of the response
 // assuming the last byte is CRC8 checksum.
 func (d *Device) readAndCheckResponse(command uint16, response []byte) (
@@ -72,32 +163,15 @@ func (d *Device) readAndCheckResponse(command uint16, response []byte) (
 		)
 	}
 
-	err = d.readResponse(command, response)
-	if err != nil {
-		return err
-	}
-
-	return checkCRC8(response)
+	return d.transact(commandBytes(command), response, validateCRC8)
 }
 
 // writeValue writes setting value for the provided command.
 func (d *Device) writeValue(command, value uint16) (err error) {
-	err = d.bus.Tx(
-		uint16(d.Address),
-		[]byte{
-			uint8(command >> 8),
-			uint8(command & 0xFF),
-			uint8(value >> 8),
-			uint8(value & 0xFF),
-			uint8(computeCRC8([]byte{uint8(value >> 8), uint8(value & 0xFF)}, 2)),
-		},
-		[]byte{},
-	)
-	if err != nil {
-		return fmt.Errorf("failed to send data: %w", err)
-	}
+	frame := append(commandBytes(command), commandBytes(value)...)
+	frame = append(frame, computeCRC8(commandBytes(value), 2))
 
-	return nil
+	return d.transact(frame, []byte{}, nil)
 }
 
 // SoftReset resets the sensor.
@@ -273,45 +347,63 @@ func (d *Device) HasDataReady() (isReady bool, err error) {
 	return uint16(result[1]) == HAS_DATA_READY, nil
 }
 
-// ReadMeasurement reads measurements from the device.
+// ReadMeasurement reads measurements from the device. A stray CRC failure
+// anywhere in the 18-byte payload retries the whole transaction (up to
+// Device.MaxRetries attempts in all) rather than discarding the sample
+// outright.
 func (d *Device) ReadMeasurement() (measurement Measurement, err error) {
 	result := make([]byte, 18)
 
-	err = d.readResponse(CMD_READ_MEASUREMENT, result)
+	err = d.transact(commandBytes(CMD_READ_MEASUREMENT), result, validateMeasurementCRC)
 	if err != nil {
 		return measurement, err
 	}
 
-	// 18 bytes response has three measurement results for CO2, temperature, and
-	// humidity, each has: 4 bytes of the value + 2 CRC8's for every bytes couple.
-	// Values come in BigEndian notation.
-	//
-	//                      CRC8
-	//       + - - + - - - + - - + - - - + - - +
-	//       |     |       |     |       |     |
-	//       v     v       v     v       v     v
-	// +-------------+-------------+-------------+
-	// | X X C X X C | X X C X X C | X X C X X C |
-	// +-------------+-------------+-------------+
-	// |             |             |             |
-	// |     CO2     | Temperature |  Humidity   |
-	//
+	return decodeMeasurement(result), nil
+}
+
+// validateMeasurementCRC checks the CRC8 of each 3-byte chunk of an 18-byte
+// ReadMeasurement response.
+//
+// 18 bytes response has three measurement results for CO2, temperature, and
+// humidity, each has: 4 bytes of the value + 2 CRC8's for every bytes couple.
+// Values come in BigEndian notation.
+//
+//                      CRC8
+//       + - - + - - - + - - + - - - + - - +
+//       |     |       |     |       |     |
+//       v     v       v     v       v     v
+// +-------------+-------------+-------------+
+// | X X C X X C | X X C X X C | X X C X X C |
+// +-------------+-------------+-------------+
+// |             |             |             |
+// |     CO2     | Temperature |  Humidity   |
+func validateMeasurementCRC(response []byte) error {
+	chunk := make([]byte, 3)
+
+	for i := 0; i+3 <= len(response); i += 3 {
+		chunk[0], chunk[1], chunk[2] = response[i], response[i+1], response[i+2]
+		if err := checkCRC8(chunk); err != nil {
+			return fmt.Errorf("%w: %v", ErrCRC, err)
+		}
+	}
+
+	return nil
+}
+
+// decodeMeasurement decodes an already CRC-validated 18-byte ReadMeasurement
+// response into a Measurement.
+func decodeMeasurement(response []byte) (measurement Measurement) {
 	i := 0
-	chunk := make([]byte, 3)     // 2 data bytes + 1 CRC8
 	var value uint32             // 4 bytes of a single measurement
 	values := make([]float32, 3) // all 3 measurements as floats
 	for v := 0; v < len(values); v++ {
 		value = 0
-		for c := 0; c < 2; c++ { // 2 chunks per one value
-			chunk[0], chunk[1], chunk[2] = result[i], result[i+1], result[i+2]
-			err = checkCRC8(chunk)
-			if err != nil {
-				return measurement, err
-			}
+		for c := 0; c < 2; c++ { // 2 chunks per one value, CRC8 byte skipped
 			value <<= 8
-			value |= uint32(result[i])
+			value |= uint32(response[i])
 			value <<= 8
-			value |= uint32(result[i+1])
+			value |= uint32(response[i+1])
 			i += 3
 		}
 		values[v] = math.Float32frombits(value)
@@ -321,7 +413,19 @@ func (d *Device) ReadMeasurement() (measurement Measurement, err error) {
 	measurement.Temperature = values[1]
 	measurement.Humidity = values[2]
 
-	return measurement, nil
+	return measurement
+}
+
+// ReadFiltered reads a measurement from the device and runs it through f,
+// returning the smoothed reading with its derived dew point, absolute
+// humidity, and IAQ level.
+func (d *Device) ReadFiltered(f *Filter) (processed Processed, err error) {
+	m, err := d.ReadMeasurement()
+	if err != nil {
+		return processed, err
+	}
+
+	return f.Apply(m), nil
 }
 
 // New create a new Sensirion SCD30 driver.
@@ -330,7 +434,9 @@ func New(bus I2C) (d Device) {
 		Address: I2C_ADDRESS,
 		// Manual testing shows that 150ms is probably the most stable default.
 		ClockStretching: 150 * time.Millisecond,
+		MaxRetries:      3,
 		bus:             bus,
+		busMu:           &sync.Mutex{},
 	}
 
 	return d
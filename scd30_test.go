@@ -0,0 +1,108 @@
+package scd30
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// fakeI2C is a minimal I2C fake that fails the first failFor calls with err,
+// then succeeds.
+type fakeI2C struct {
+	calls   int
+	failFor int
+	err     error
+}
+
+func (f *fakeI2C) Tx(addr uint16, w, r []byte) error {
+	f.calls++
+	if f.failFor > 0 {
+		f.failFor--
+		return f.err
+	}
+	return nil
+}
+
+func TestIsTransient(t *testing.T) {
+	transient := []error{
+		ErrCRC, ErrBusIO, ErrTimeout, ErrNotReady,
+		fmt.Errorf("read failed: %w", ErrCRC),
+	}
+	for _, err := range transient {
+		if !isTransient(err) {
+			t.Errorf("isTransient(%v) = false, want true", err)
+		}
+	}
+
+	notTransient := []error{errors.New("permanent failure"), nil}
+	for _, err := range notTransient {
+		if isTransient(err) {
+			t.Errorf("isTransient(%v) = true, want false", err)
+		}
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	cases := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, 10 * time.Millisecond},
+		{1, 20 * time.Millisecond},
+		{2, 40 * time.Millisecond},
+		{3, 80 * time.Millisecond},
+		{10, 500 * time.Millisecond}, // capped
+	}
+
+	for _, c := range cases {
+		if got := retryBackoff(c.attempt); got != c.want {
+			t.Errorf("retryBackoff(%d) = %s, want %s", c.attempt, got, c.want)
+		}
+	}
+}
+
+func TestTransactRetriesTransientErrors(t *testing.T) {
+	bus := &fakeI2C{failFor: 2, err: fmt.Errorf("nack: %w", ErrBusIO)}
+	d := New(bus)
+	d.MaxRetries = 3
+
+	if err := d.transact([]byte{0x01, 0x02}, nil, nil); err != nil {
+		t.Fatalf("transact() = %v, want nil after recovering", err)
+	}
+	if bus.calls != 3 {
+		t.Errorf("bus.calls = %d, want 3 (2 failures + 1 success)", bus.calls)
+	}
+}
+
+func TestTransactReturnsErrorAfterExhaustingRetries(t *testing.T) {
+	bus := &fakeI2C{failFor: 10, err: fmt.Errorf("nack: %w", ErrBusIO)}
+	d := New(bus)
+	d.MaxRetries = 2
+
+	if err := d.transact([]byte{0x01, 0x02}, nil, nil); err == nil {
+		t.Fatal("transact() = nil, want error")
+	}
+	if bus.calls != 2 {
+		t.Errorf("bus.calls = %d, want 2 (Device.MaxRetries)", bus.calls)
+	}
+}
+
+func TestTransactDoesNotRetryNonTransientValidationErrors(t *testing.T) {
+	bus := &fakeI2C{}
+	d := New(bus)
+	d.MaxRetries = 5
+
+	validateCalls := 0
+	validate := func([]byte) error {
+		validateCalls++
+		return errors.New("malformed response")
+	}
+
+	if err := d.transact([]byte{0x01, 0x02}, []byte{0, 0, 0}, validate); err == nil {
+		t.Fatal("transact() = nil, want error")
+	}
+	if validateCalls != 1 {
+		t.Errorf("validate called %d times, want 1 (no retry on non-transient error)", validateCalls)
+	}
+}
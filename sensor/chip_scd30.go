@@ -0,0 +1,18 @@
+package sensor
+
+import "github.com/antonfisher/scd30"
+
+// SCD30Chip adapts a *scd30.Device to the Chip interface.
+type SCD30Chip struct {
+	Device *scd30.Device
+}
+
+// HasDataReady reports whether a new measurement is available to read.
+func (c *SCD30Chip) HasDataReady() (bool, error) {
+	return c.Device.HasDataReady()
+}
+
+// ReadMeasurement reads and decodes the next measurement.
+func (c *SCD30Chip) ReadMeasurement() (scd30.Measurement, error) {
+	return c.Device.ReadMeasurement()
+}
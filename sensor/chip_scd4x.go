@@ -0,0 +1,119 @@
+package sensor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/antonfisher/scd30"
+)
+
+// SCD4xAddress is the fixed I2C address shared by the SCD40 and SCD41.
+const SCD4xAddress uint8 = 0x62
+
+const (
+	cmdSCD4xStartPeriodicMeasurement uint16 = 0x21b1
+	cmdSCD4xStopPeriodicMeasurement  uint16 = 0x3f86
+	cmdSCD4xReadMeasurement          uint16 = 0xec05
+	cmdSCD4xGetDataReadyStatus       uint16 = 0xe4b8
+
+	// scd4xExecutionTime is the sensor's documented max command processing
+	// time; comfortably covers get_data_ready_status and read_measurement.
+	scd4xExecutionTime = 2 * time.Millisecond
+)
+
+// SCD4xChip adapts a Sensirion SCD40/SCD41 to the Chip interface. The two
+// parts share the same I2C address, command opcodes, and 9-byte
+// measurement layout; SCD41 additionally supports single-shot mode, which
+// this adapter does not use.
+type SCD4xChip struct {
+	// Bus is the I2C bus the sensor is attached to. It uses the same
+	// per-call-address Tx signature as scd30.I2C, so a bus already wired
+	// up for an SCD30 can be reused as-is.
+	Bus scd30.I2C
+
+	// Address is the sensor's I2C address, normally SCD4xAddress.
+	Address uint8
+}
+
+// NewSCD4xChip creates an SCD4xChip at the default SCD40/SCD41 address.
+func NewSCD4xChip(bus scd30.I2C) *SCD4xChip {
+	return &SCD4xChip{Bus: bus, Address: SCD4xAddress}
+}
+
+// StartPeriodicMeasurement puts the sensor into periodic measurement mode,
+// sampling every 5s. Must be called before HasDataReady/ReadMeasurement
+// will return data.
+func (c *SCD4xChip) StartPeriodicMeasurement() error {
+	return c.writeCommand(cmdSCD4xStartPeriodicMeasurement)
+}
+
+// StopPeriodicMeasurement stops periodic measurement mode.
+func (c *SCD4xChip) StopPeriodicMeasurement() error {
+	return c.writeCommand(cmdSCD4xStopPeriodicMeasurement)
+}
+
+// HasDataReady reports whether a new measurement is available to read.
+func (c *SCD4xChip) HasDataReady() (bool, error) {
+	response := make([]byte, 3)
+	if err := c.readResponse(cmdSCD4xGetDataReadyStatus, response); err != nil {
+		return false, err
+	}
+
+	// Data is pending unless the low 11 bits of the status word are zero.
+	status := uint16(response[0])<<8 | uint16(response[1])
+
+	return status&0x07FF != 0, nil
+}
+
+// ReadMeasurement reads and decodes the next measurement.
+func (c *SCD4xChip) ReadMeasurement() (scd30.Measurement, error) {
+	var measurement scd30.Measurement
+
+	response := make([]byte, 9)
+	if err := c.readResponse(cmdSCD4xReadMeasurement, response); err != nil {
+		return measurement, err
+	}
+
+	// 9 bytes: CO2, temperature, humidity, each 2 raw bytes + 1 CRC8.
+	// Unlike the SCD30's IEEE-754 float32 values, the SCD4x returns raw
+	// uint16 counts that are linearly scaled per the datasheet.
+	chunk := make([]byte, 3)
+	raw := make([]uint16, 3)
+	for v := 0; v < 3; v++ {
+		i := v * 3
+		chunk[0], chunk[1], chunk[2] = response[i], response[i+1], response[i+2]
+		if err := checkCRC8(chunk); err != nil {
+			return measurement, err
+		}
+		raw[v] = uint16(chunk[0])<<8 | uint16(chunk[1])
+	}
+
+	measurement.CO2 = float32(raw[0])
+	measurement.Temperature = -45 + 175*(float32(raw[1])/65536)
+	measurement.Humidity = 100 * (float32(raw[2]) / 65536)
+
+	return measurement, nil
+}
+
+func (c *SCD4xChip) writeCommand(command uint16) error {
+	err := c.Bus.Tx(uint16(c.Address), []byte{uint8(command >> 8), uint8(command & 0xFF)}, []byte{})
+	if err != nil {
+		return fmt.Errorf("failed to send data: %w", err)
+	}
+
+	return nil
+}
+
+func (c *SCD4xChip) readResponse(command uint16, response []byte) error {
+	if err := c.writeCommand(command); err != nil {
+		return err
+	}
+
+	time.Sleep(scd4xExecutionTime)
+
+	if err := c.Bus.Tx(uint16(c.Address), []byte{}, response); err != nil {
+		return fmt.Errorf("failed to read data: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package sensor
+
+import (
+	"fmt"
+
+	"github.com/antonfisher/scd30"
+)
+
+// Sensirion's SCD4x uses the same CRC8 algorithm (poly 0x31, init 0xFF) as
+// the SCD30 implemented in the scd30 package, so the check is duplicated
+// here rather than exported across an unrelated chip boundary.
+
+// checkCRC8 checks CRC8 of a byte array assuming the last item is a
+// checksum, wrapping a mismatch as scd30.ErrCRC so callers can react to it
+// the same way they would for an SCD30 (see scd30.ErrCRC).
+func checkCRC8(data []byte) error {
+	l := uint8(len(data))
+	expectedCRC := data[l-1]
+	crc := computeCRC8(data, l-1)
+
+	if crc != expectedCRC {
+		return fmt.Errorf(
+			"%w: expected '%x', got '%x'",
+			scd30.ErrCRC,
+			expectedCRC,
+			crc,
+		)
+	}
+
+	return nil
+}
+
+// computeCRC8 computes CRC8 of the l first bytes of the given byte array.
+func computeCRC8(data []byte, l uint8) uint8 {
+	crc := uint8(0xFF)
+
+	for x := uint8(0); x < l; x++ {
+		crc ^= data[x]
+		for i := 0; i < 8; i++ {
+			if (crc & 0x80) != 0 {
+				crc = uint8((crc << 1) ^ 0x31)
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
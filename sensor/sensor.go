@@ -0,0 +1,127 @@
+// Package sensor provides a higher-level, pollable wrapper around a
+// Sensirion CO2/temperature/humidity chip, on top of the low-level
+// register access implemented by the scd30 package.
+//
+// The polling loop, backoff, and measurement delivery implemented here are
+// chip-agnostic: a Sensor is constructed around anything satisfying the
+// Chip interface, so the same firmware can be written once and pointed at
+// an SCD30 (via SCD30Chip) or at an SCD40/SCD41 (via SCD4xChip), which use
+// different I2C addresses, command opcodes, and measurement layouts.
+package sensor
+
+import (
+	"context"
+	"time"
+
+	"github.com/antonfisher/scd30"
+)
+
+// Chip abstracts the minimal set of operations Sensor needs to poll a
+// Sensirion CO2 sensor. scd30.Device satisfies it through the SCD30Chip
+// adapter; an SCD40/SCD41 driver can satisfy it directly.
+type Chip interface {
+	// HasDataReady reports whether a new measurement is available to read.
+	HasDataReady() (bool, error)
+
+	// ReadMeasurement reads and decodes the next measurement.
+	ReadMeasurement() (scd30.Measurement, error)
+}
+
+// Sensor polls a Chip on an interval and delivers measurements to a
+// channel, applying exponential backoff while the chip is returning
+// errors.
+type Sensor struct {
+	Chip Chip
+
+	// Interval is the poll period used while the chip is healthy.
+	Interval time.Duration
+
+	// MaxBackoff caps the poll period after consecutive errors.
+	MaxBackoff time.Duration
+
+	// OnError, if set, is called with every error encountered while
+	// polling, including ones that trigger backoff.
+	OnError func(error)
+}
+
+// New creates a Sensor wrapping the provided Chip with sensible polling
+// defaults.
+func New(chip Chip) Sensor {
+	return Sensor{
+		Chip:       chip,
+		Interval:   2 * time.Second,
+		MaxBackoff: 30 * time.Second,
+	}
+}
+
+// Run polls the chip until ctx is cancelled, sending each measurement to
+// measurements. Run blocks and only returns once ctx is done, so callers
+// typically invoke it in its own goroutine.
+func (s *Sensor) Run(ctx context.Context, measurements chan<- scd30.Measurement) error {
+	interval := s.Interval
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+
+	backoff := interval
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			ready, err := s.Chip.HasDataReady()
+			if err != nil {
+				s.reportError(err)
+				backoff = s.backoffAfterError(backoff, interval)
+				ticker.Reset(backoff)
+				continue
+			}
+			if !ready {
+				continue
+			}
+
+			m, err := s.Chip.ReadMeasurement()
+			if err != nil {
+				s.reportError(err)
+				backoff = s.backoffAfterError(backoff, interval)
+				ticker.Reset(backoff)
+				continue
+			}
+
+			backoff = interval
+			ticker.Reset(backoff)
+
+			select {
+			case measurements <- m:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+func (s *Sensor) reportError(err error) {
+	if s.OnError != nil {
+		s.OnError(err)
+	}
+}
+
+func (s *Sensor) backoffAfterError(current, interval time.Duration) time.Duration {
+	max := s.MaxBackoff
+	if max <= 0 {
+		max = 30 * time.Second
+	}
+
+	next := current * 2
+	if next < interval {
+		next = interval
+	}
+	if next > max {
+		next = max
+	}
+
+	return next
+}